@@ -65,6 +65,9 @@ spec:
         - --zap-log-level={{ default 4 .Values.manager.options.logLevel }}
         - --configuration-name={{ .Values.manager.options.capsuleConfiguration }}
         - --workers={{ .Values.manager.options.workers }}
+        {{- with .Values.manager.options.quotaUpdateWorkers }}
+        - --quota-update-workers={{ . }}
+        {{- end }}
         - --client-connection-qps={{ .Values.manager.options.clientConnectionQPS }}
         - --client-connection-burst={{ .Values.manager.options.clientConnectionBurst }}
         {{- with .Values.manager.options.cacheSyncTimeout }}
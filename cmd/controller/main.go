@@ -158,6 +158,12 @@ func main() {
 		1,
 		"MaxConcurrentReconciles is the maximum number of concurrent Reconciles which can be run.",
 	)
+	flag.IntVar(
+		&controllerConfig.Runtime.MaxConcurrentQuotaUpdates,
+		"quota-update-workers",
+		0,
+		"The maximum number of ResourceQuota items updated in parallel when propagating a Tenant-scoped quota. If unset or 0, updates are not bounded.",
+	)
 	flag.DurationVar(
 		&cacheSyncTimeout,
 		"cache-sync-timeout",
@@ -6,19 +6,23 @@ package e2e
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/projectcapsule/capsule/pkg/api"
 	"github.com/projectcapsule/capsule/pkg/api/meta"
 	"github.com/projectcapsule/capsule/pkg/api/rbac"
+	evt "github.com/projectcapsule/capsule/pkg/runtime/events"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
 )
@@ -190,6 +194,22 @@ var _ = Describe("exceeding a Tenant resource quota", Ordered, Label("resourcequ
 					return rq.Status.Hard.Pods().String() == rq.Status.Used.Pods().String()
 				}, defaultTimeoutInterval, defaultPollInterval).Should(BeTrue())
 			})
+			By("ensuring the blocked Resource Quota is traced back to the Tenant", func() {
+				Eventually(func() bool {
+					list := &eventsv1.EventList{}
+					if err := k8sClient.List(context.TODO(), list, client.InNamespace(ns)); err != nil {
+						return false
+					}
+
+					for _, e := range list.Items {
+						if e.Regarding.Name == n && e.Reason == evt.ReasonQuotaExceeded && strings.Contains(e.Note, tnt.GetName()) {
+							return true
+						}
+					}
+
+					return false
+				}, defaultTimeoutInterval, defaultPollInterval).Should(BeTrue())
+			})
 			By("creating an exceeded Pod", func() {
 				pod := &corev1.Pod{
 					ObjectMeta: metav1.ObjectMeta{
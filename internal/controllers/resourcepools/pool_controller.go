@@ -34,6 +34,7 @@ import (
 	caperrors "github.com/projectcapsule/capsule/pkg/api/errors"
 	"github.com/projectcapsule/capsule/pkg/api/meta"
 	evt "github.com/projectcapsule/capsule/pkg/runtime/events"
+	"github.com/projectcapsule/capsule/pkg/runtime/selectors"
 	"github.com/projectcapsule/capsule/pkg/utils"
 )
 
@@ -134,7 +135,10 @@ func (r resourcePoolController) Reconcile(ctx context.Context, request ctrl.Requ
 			return
 		}
 
-		err = nil
+		// Transient errors are returned to be retried with backoff.
+		if !selectors.IsTransientError(err) {
+			err = nil
+		}
 	}()
 
 	err = r.reconcile(ctx, log, instance)
@@ -167,10 +171,18 @@ func (r *resourcePoolController) reconcile(
 	r.handlePoolHardResources(pool)
 
 	namespaces, err := r.gatherMatchingNamespaces(ctx, log, pool)
-	if err != nil {
+	if err != nil && !selectors.IsSelectorError(err) {
 		return err
 	}
 
+	// Invalid selectors don't block the remaining ones, they are reported
+	// with the Ready condition once the reconciliation completes.
+	if selectorErr := err; selectorErr != nil {
+		defer func() {
+			err = errors.Join(selectorErr, err)
+		}()
+	}
+
 	currentNamespaces := make(map[string]struct{}, len(namespaces))
 	for _, ns := range namespaces {
 		currentNamespaces[ns.Name] = struct{}{}
@@ -686,6 +698,21 @@ func (r *resourcePoolController) syncResourceQuota(
 		},
 	}
 
+	// The Namespace could have started terminating after being collected:
+	// a ResourceQuota created now would only be left for the garbage collection.
+	current := &corev1.Namespace{}
+	if err := reader.Get(ctx, types.NamespacedName{Name: namespace.GetName()}, current); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	if !current.DeletionTimestamp.IsZero() || current.Status.Phase == corev1.NamespaceTerminating {
+		return nil
+	}
+
 	if err := reader.Get(ctx, types.NamespacedName{Name: target.Name, Namespace: target.Namespace}, target); err != nil && !apierrors.IsNotFound(err) {
 		return err
 	}
@@ -713,6 +740,10 @@ func (r *resourcePoolController) syncResourceQuota(
 		return retryErr
 	})
 	if err != nil {
+		if apierrors.HasStatusCause(err, corev1.NamespaceTerminatingCause) {
+			return nil
+		}
+
 		return err
 	}
 
@@ -743,6 +774,8 @@ func (r *resourcePoolController) gatherMatchingNamespaces(
 	namespaces = make([]corev1.Namespace, 0)
 	seenNamespaces := make(map[string]struct{})
 
+	var invalid []error
+
 	if !pool.DeletionTimestamp.IsZero() {
 		return namespaces, err
 	}
@@ -750,7 +783,15 @@ func (r *resourcePoolController) gatherMatchingNamespaces(
 	for _, selector := range pool.Spec.Selectors {
 		selected, serr := selector.GetMatchingNamespaces(ctx, r.reader)
 		if serr != nil {
-			log.Error(err, "Cannot get matching namespaces")
+			// Namespaces can't be listed, retry instead of releasing the
+			// namespaces of this selector.
+			if !selectors.IsSelectorError(serr) {
+				return nil, serr
+			}
+
+			log.Error(serr, "Cannot get matching namespaces")
+
+			invalid = append(invalid, serr)
 
 			continue
 		}
@@ -770,7 +811,7 @@ func (r *resourcePoolController) gatherMatchingNamespaces(
 		}
 	}
 
-	return namespaces, err
+	return namespaces, errors.Join(invalid...)
 }
 
 // Get Currently selected claims for the resourcepool.
@@ -959,6 +1000,10 @@ func (r *resourcePoolController) updateStatus(ctx context.Context, instance *cap
 			readyCondition.Message = reconcileError.Error()
 			readyCondition.Status = metav1.ConditionFalse
 			readyCondition.Reason = meta.FailedReason
+
+			if selectors.IsSelectorError(reconcileError) {
+				readyCondition.Reason = meta.InvalidSelectorReason
+			}
 		}
 
 		latest.Status.Conditions.UpdateConditionByType(readyCondition)
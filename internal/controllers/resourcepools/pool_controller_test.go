@@ -5,12 +5,25 @@ package resourcepools
 
 import (
 	"context"
+	"errors"
+	"slices"
 	"testing"
 
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
 	"github.com/projectcapsule/capsule/pkg/api/meta"
+	"github.com/projectcapsule/capsule/pkg/runtime/selectors"
 )
 
 func TestResourcePoolFinalize(t *testing.T) {
@@ -65,3 +78,244 @@ func TestResourcePoolFinalize(t *testing.T) {
 		})
 	}
 }
+
+func TestResourcePoolSyncResourceQuotaSkipsTerminatingNamespace(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		terminating bool
+		deleted     bool
+		wantQuota   bool
+	}{
+		{
+			name:      "creates quota in active namespace",
+			wantQuota: true,
+		},
+		{
+			name:        "skips namespace terminating after collection",
+			terminating: true,
+		},
+		{
+			name:    "skips namespace deleted after collection",
+			deleted: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			scheme := runtime.NewScheme()
+			for _, add := range []func(*runtime.Scheme) error{
+				clientgoscheme.AddToScheme,
+				capsulev1beta2.AddToScheme,
+			} {
+				if err := add(scheme); err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+			}
+
+			// The namespace as collected by the reconciliation, still active.
+			collected := corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: "solar-prod"},
+				Status:     corev1.NamespaceStatus{Phase: corev1.NamespaceActive},
+			}
+
+			builder := fake.NewClientBuilder().WithScheme(scheme)
+
+			if !tt.deleted {
+				stored := collected.DeepCopy()
+
+				if tt.terminating {
+					now := metav1.Now()
+					stored.DeletionTimestamp = &now
+					stored.Finalizers = []string{"kubernetes"}
+					stored.Status.Phase = corev1.NamespaceTerminating
+				}
+
+				builder = builder.WithObjects(stored)
+			}
+
+			c := builder.Build()
+
+			pool := &capsulev1beta2.ResourcePool{
+				ObjectMeta: metav1.ObjectMeta{Name: "pool", UID: "pool-uid"},
+			}
+
+			if err := (&resourcePoolController{}).syncResourceQuota(context.Background(), c, c, pool, collected); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			rq := &corev1.ResourceQuota{}
+			err := c.Get(context.Background(), types.NamespacedName{Name: pool.GetQuotaName(), Namespace: collected.Name}, rq)
+
+			switch {
+			case tt.wantQuota && err != nil:
+				t.Fatalf("expected ResourceQuota, got %v", err)
+			case !tt.wantQuota && !apierrors.IsNotFound(err):
+				t.Fatalf("expected no ResourceQuota, got %v", err)
+			}
+		})
+	}
+}
+
+func TestResourcePoolGatherMatchingNamespacesErrors(t *testing.T) {
+	t.Parallel()
+
+	invalid := selectors.NamespaceSelector{
+		LabelSelector: &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "env", Operator: "Unknown", Values: []string{"prod"}},
+			},
+		},
+	}
+
+	valid := selectors.NamespaceSelector{
+		LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+	}
+
+	tests := []struct {
+		name           string
+		selectors      []selectors.NamespaceSelector
+		listErr        error
+		wantNamespaces []string
+		wantSelector   bool
+		wantTransient  bool
+	}{
+		{
+			name:           "valid selector",
+			selectors:      []selectors.NamespaceSelector{valid},
+			wantNamespaces: []string{"solar-prod"},
+		},
+		{
+			name:           "invalid selector does not block valid ones",
+			selectors:      []selectors.NamespaceSelector{invalid, valid},
+			wantNamespaces: []string{"solar-prod"},
+			wantSelector:   true,
+		},
+		{
+			name:          "namespaces can't be listed",
+			selectors:     []selectors.NamespaceSelector{valid},
+			listErr:       errors.New("connection refused"),
+			wantTransient: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			scheme := runtime.NewScheme()
+			if err := clientgoscheme.AddToScheme(scheme); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(
+					&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "solar-prod", Labels: map[string]string{"env": "prod"}}},
+					&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "solar-dev", Labels: map[string]string{"env": "dev"}}},
+				).
+				WithInterceptorFuncs(interceptor.Funcs{
+					List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+						if tt.listErr != nil {
+							return tt.listErr
+						}
+
+						return c.List(ctx, list, opts...)
+					},
+				}).
+				Build()
+
+			pool := &capsulev1beta2.ResourcePool{
+				ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+				Spec:       capsulev1beta2.ResourcePoolSpec{Selectors: tt.selectors},
+			}
+
+			r := &resourcePoolController{Client: c, reader: c}
+
+			namespaces, err := r.gatherMatchingNamespaces(context.Background(), logr.Discard(), pool)
+
+			if got := selectors.IsSelectorError(err); got != tt.wantSelector {
+				t.Fatalf("expected selector error %t, got %t (%v)", tt.wantSelector, got, err)
+			}
+
+			if got := selectors.IsTransientError(err); got != tt.wantTransient {
+				t.Fatalf("expected transient error %t, got %t (%v)", tt.wantTransient, got, err)
+			}
+
+			names := make([]string, 0, len(namespaces))
+			for _, ns := range namespaces {
+				names = append(names, ns.Name)
+			}
+
+			if !slices.Equal(names, tt.wantNamespaces) {
+				t.Fatalf("expected namespaces %v, got %v", tt.wantNamespaces, names)
+			}
+		})
+	}
+}
+
+func TestResourcePoolUpdateStatusSelectorError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus metav1.ConditionStatus
+		wantReason string
+	}{
+		{
+			name:       "successful reconciliation",
+			wantStatus: metav1.ConditionTrue,
+			wantReason: meta.SucceededReason,
+		},
+		{
+			name:       "invalid selector",
+			err:        &selectors.SelectorError{Err: errors.New("\"Unknown\" is not a valid label selector operator")},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: meta.InvalidSelectorReason,
+		},
+		{
+			name:       "reconciliation failure",
+			err:        errors.New("reconcile claims in use"),
+			wantStatus: metav1.ConditionFalse,
+			wantReason: meta.FailedReason,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			scheme := runtime.NewScheme()
+			if err := capsulev1beta2.AddToScheme(scheme); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			pool := &capsulev1beta2.ResourcePool{
+				ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+			}
+
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(pool).
+				WithStatusSubresource(pool).
+				Build()
+
+			if err := (&resourcePoolController{Client: c, reader: c}).updateStatus(context.Background(), pool, tt.err); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			condition := pool.Status.Conditions.GetConditionByType(meta.ReadyCondition)
+			if condition == nil {
+				t.Fatalf("expected %s condition, got none", meta.ReadyCondition)
+			}
+
+			if condition.Status != tt.wantStatus || condition.Reason != tt.wantReason {
+				t.Fatalf("expected %s/%s, got %s/%s", tt.wantStatus, tt.wantReason, condition.Status, condition.Reason)
+			}
+		})
+	}
+}
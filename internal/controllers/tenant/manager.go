@@ -62,6 +62,8 @@ type Manager struct {
 	RESTConfig    *rest.Config
 	classes       supportedClasses
 
+	quotaUpdateWorkers int
+
 	discoveryCache cache.DiscoveryNamespacedResourceCache
 }
 
@@ -72,6 +74,7 @@ type supportedClasses struct {
 
 func (r *Manager) SetupWithManager(mgr ctrl.Manager, ctrlConfig utils.ControllerOptions) error {
 	r.reader = mgr.GetAPIReader()
+	r.quotaUpdateWorkers = ctrlConfig.Runtime.MaxConcurrentQuotaUpdates
 	r.discoveryCache = cache.NewDiscoveryNamespacedResourceCache()
 
 	ctrlBuilder := ctrl.NewControllerManagedBy(mgr).
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"strconv"
 	"strings"
 
@@ -27,6 +28,7 @@ import (
 	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
 	"github.com/projectcapsule/capsule/pkg/api"
 	"github.com/projectcapsule/capsule/pkg/api/meta"
+	evt "github.com/projectcapsule/capsule/pkg/runtime/events"
 	"github.com/projectcapsule/capsule/pkg/utils"
 )
 
@@ -126,6 +128,9 @@ func (r *Manager) syncResourceQuotas(ctx context.Context, log logr.Logger, tenan
 						strconv.Itoa(index),
 					).Set(float64(hardQuota.MilliValue()) / 1000)
 
+					// ResourceQuota items getting blocked by the current reconciliation.
+					var blocked []int
+
 					switch quantity.Cmp(resourceQuota.Hard[name]) {
 					case 0:
 						// The Tenant is matching exactly the Quota:
@@ -137,8 +142,14 @@ func (r *Manager) syncResourceQuotas(ctx context.Context, log logr.Logger, tenan
 						// updating all the related ResourceQuota with the current
 						// used Quota to block further creations.
 						for item := range list.Items {
-							if _, ok := list.Items[item].Status.Used[name]; ok {
-								list.Items[item].Spec.Hard[name] = list.Items[item].Status.Used[name]
+							// Debouncing on the transition into the blocked state: the Tenant usage
+							// recorded by the previous reconciliation was still below the hard quota.
+							if !quotaWasBlocked(&list.Items[item], name) {
+								blocked = append(blocked, item)
+							}
+
+							if used, ok := list.Items[item].Status.Used[name]; ok {
+								list.Items[item].Spec.Hard[name] = used
 							} else {
 								um := make(map[corev1.ResourceName]resource.Quantity)
 								um[name] = resource.Quantity{}
@@ -177,6 +188,23 @@ func (r *Manager) syncResourceQuotas(ctx context.Context, log logr.Logger, tenan
 
 						return scopeErr
 					}
+
+					// The native ResourceQuota admission rejects further workloads with a generic
+					// message: recording the Tenant on the blocked ResourceQuota makes it traceable.
+					for _, item := range blocked {
+						r.Recorder.Eventf(
+							&list.Items[item],
+							tenant,
+							corev1.EventTypeWarning,
+							evt.ReasonQuotaExceeded,
+							evt.ActionReconciled,
+							"Tenant %s reached the %s hard quota (%s) of ResourceQuota %s: further allocations are blocked",
+							tenant.Name,
+							name.String(),
+							hardQuota.String(),
+							list.Items[item].Name,
+						)
+					}
 				}
 
 				return nil
@@ -297,6 +325,7 @@ func (r *Manager) syncResourceQuota(ctx context.Context, log logr.Logger, tenant
 // Serial ResourceQuota processing is expensive: using Go routines we can speed it up.
 // In case of multiple errors these are logged properly, returning a generic error since we have to repush back the
 // reconciliation loop.
+// The amount of in-flight updates is bounded by the configured quota update workers, if any.
 func (r *Manager) resourceQuotasUpdate(
 	ctx context.Context,
 	log logr.Logger,
@@ -307,6 +336,9 @@ func (r *Manager) resourceQuotasUpdate(
 	list ...corev1.ResourceQuota,
 ) (err error) {
 	group := new(errgroup.Group)
+	if r.quotaUpdateWorkers > 0 {
+		group.SetLimit(r.quotaUpdateWorkers)
+	}
 
 	annotationsToKeep := sets.New[string]()
 
@@ -353,7 +385,10 @@ func (r *Manager) resourceQuotasUpdate(
 						}
 					}
 
-					found.Labels = rq.Labels
+					// Merging rather than replacing: labels set by external controllers
+					// after the ResourceQuota list has been fetched must survive.
+					maps.Copy(found.Labels, rq.Labels)
+
 					if actualKey, keyErr := capsulev1beta2.UsedQuotaFor(resourceName); keyErr == nil {
 						found.Annotations[actualKey] = actual.String()
 					}
@@ -387,3 +422,36 @@ func (r *Manager) resourceQuotasUpdate(
 
 	return err
 }
+
+// quotaWasBlocked returns true when the Tenant usage and hard quota, recorded on the ResourceQuota
+// annotations by the previous reconciliation, report the given resource as already exhausted.
+func quotaWasBlocked(rq *corev1.ResourceQuota, name corev1.ResourceName) bool {
+	usedKey, err := capsulev1beta2.UsedQuotaFor(name)
+	if err != nil {
+		return false
+	}
+
+	hardKey, err := capsulev1beta2.HardQuotaFor(name)
+	if err != nil {
+		return false
+	}
+
+	usedValue, usedFound := rq.GetAnnotations()[usedKey]
+	hardValue, hardFound := rq.GetAnnotations()[hardKey]
+
+	if !usedFound || !hardFound {
+		return false
+	}
+
+	used, err := resource.ParseQuantity(usedValue)
+	if err != nil {
+		return false
+	}
+
+	hard, err := resource.ParseQuantity(hardValue)
+	if err != nil {
+		return false
+	}
+
+	return used.Cmp(hard) >= 0
+}
@@ -0,0 +1,405 @@
+// Copyright 2020-2026 Project Capsule Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/internal/metrics"
+	"github.com/projectcapsule/capsule/pkg/api"
+	"github.com/projectcapsule/capsule/pkg/api/meta"
+	evt "github.com/projectcapsule/capsule/pkg/runtime/events"
+)
+
+func TestResourceQuotasUpdateBoundedConcurrency(t *testing.T) {
+	tests := []struct {
+		name    string
+		workers int
+		items   int
+		wantMax int32
+	}{
+		{
+			name:    "single worker serializes updates",
+			workers: 1,
+			items:   6,
+			wantMax: 1,
+		},
+		{
+			name:    "updates are bounded by workers",
+			workers: 2,
+			items:   6,
+			wantMax: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			if err := clientgoscheme.AddToScheme(scheme); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			objects := make([]client.Object, 0, tt.items)
+			list := make([]corev1.ResourceQuota, 0, tt.items)
+
+			for i := range tt.items {
+				rq := corev1.ResourceQuota{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "capsule-solar-0",
+						Namespace: fmt.Sprintf("solar-%d", i),
+					},
+					Spec: corev1.ResourceQuotaSpec{
+						Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("10")},
+					},
+				}
+
+				objects = append(objects, rq.DeepCopy())
+				list = append(list, rq)
+			}
+
+			var (
+				mu       sync.Mutex
+				inflight int32
+				maxSeen  int32
+			)
+
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(objects...).
+				WithInterceptorFuncs(interceptor.Funcs{
+					Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+						mu.Lock()
+						inflight++
+						maxSeen = max(maxSeen, inflight)
+						mu.Unlock()
+
+						time.Sleep(10 * time.Millisecond)
+
+						mu.Lock()
+						inflight--
+						mu.Unlock()
+
+						return c.Get(ctx, key, obj, opts...)
+					},
+				}).
+				Build()
+
+			r := &Manager{
+				Client:             c,
+				quotaUpdateWorkers: tt.workers,
+			}
+
+			err := r.resourceQuotasUpdate(
+				context.Background(),
+				logr.Discard(),
+				corev1.ResourcePods,
+				resource.MustParse("5"),
+				sets.New(corev1.ResourcePods),
+				resource.MustParse("10"),
+				list...,
+			)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if maxSeen > tt.wantMax {
+				t.Fatalf("expected at most %d parallel updates, got %d", tt.wantMax, maxSeen)
+			}
+		})
+	}
+}
+
+func TestResourceQuotasUpdatePreservesExternalMetadata(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	listed := corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "capsule-solar-0",
+			Namespace: "solar-prod",
+			Labels:    map[string]string{meta.NewTenantLabel: "solar"},
+		},
+		Spec: corev1.ResourceQuotaSpec{
+			Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("10")},
+		},
+	}
+
+	// The external controller patched the object after it has been listed.
+	stored := listed.DeepCopy()
+	stored.Labels["cost.example.com/center"] = "platform"
+	stored.Annotations = map[string]string{"cost.example.com/owner": "finance"}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(stored).Build()
+
+	r := &Manager{Client: c}
+
+	err := r.resourceQuotasUpdate(
+		context.Background(),
+		logr.Discard(),
+		corev1.ResourcePods,
+		resource.MustParse("5"),
+		sets.New(corev1.ResourcePods),
+		resource.MustParse("10"),
+		listed,
+	)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	got := &corev1.ResourceQuota{}
+	if err := c.Get(context.Background(), client.ObjectKeyFromObject(stored), got); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if got.Labels["cost.example.com/center"] != "platform" {
+		t.Fatalf("expected external label to survive, got %v", got.Labels)
+	}
+
+	if got.Labels[meta.NewTenantLabel] != "solar" {
+		t.Fatalf("expected tenant label solar, got %v", got.Labels)
+	}
+
+	if got.Annotations["cost.example.com/owner"] != "finance" {
+		t.Fatalf("expected external annotation to survive, got %v", got.Annotations)
+	}
+
+	usedKey, err := capsulev1beta2.UsedQuotaFor(corev1.ResourcePods)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if got.Annotations[usedKey] != "5" {
+		t.Fatalf("expected used annotation 5, got %v", got.Annotations)
+	}
+}
+
+func TestSyncResourceQuotasEmitsBlockedEvents(t *testing.T) {
+	tests := []struct {
+		name string
+		// used pods per Namespace
+		used map[string]string
+		// Tenant usage recorded by the previous reconciliation
+		previousUsed string
+		wantEvents   int
+	}{
+		{
+			name:         "namespace filling the quota is reported",
+			used:         map[string]string{"solar-easy": "5", "solar-peasy": "5"},
+			previousUsed: "9",
+			wantEvents:   2,
+		},
+		{
+			name:         "single namespace reaching the quota is reported",
+			used:         map[string]string{"solar-easy": "10"},
+			previousUsed: "9",
+			wantEvents:   1,
+		},
+		{
+			name:         "already blocked quota is not reported again",
+			used:         map[string]string{"solar-easy": "5", "solar-peasy": "5"},
+			previousUsed: "10",
+		},
+		{
+			name:         "quota below the hard limit is not reported",
+			used:         map[string]string{"solar-easy": "5", "solar-peasy": "4"},
+			previousUsed: "8",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			for _, add := range []func(*runtime.Scheme) error{
+				clientgoscheme.AddToScheme,
+				capsulev1beta2.AddToScheme,
+			} {
+				if err := add(scheme); err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+			}
+
+			usedKey, err := capsulev1beta2.UsedQuotaFor(corev1.ResourcePods)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			hardKey, err := capsulev1beta2.HardQuotaFor(corev1.ResourcePods)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			tnt := &capsulev1beta2.Tenant{
+				ObjectMeta: metav1.ObjectMeta{Name: "solar"},
+				Spec: capsulev1beta2.TenantSpec{
+					ResourceQuota: api.ResourceQuotaSpec{
+						Scope: api.ResourceQuotaScopeTenant,
+						Items: []corev1.ResourceQuotaSpec{
+							{Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("10")}},
+						},
+					},
+				},
+			}
+
+			objects := make([]client.Object, 0, len(tt.used))
+
+			for namespace, used := range tt.used {
+				objects = append(objects, &corev1.ResourceQuota{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "capsule-solar-0",
+						Namespace: namespace,
+						Labels: map[string]string{
+							meta.NewTenantLabel:     tnt.Name,
+							meta.ResourceQuotaLabel: "0",
+						},
+						Annotations: map[string]string{
+							usedKey: tt.previousUsed,
+							hardKey: "10",
+						},
+					},
+					Spec: corev1.ResourceQuotaSpec{
+						Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse(used)},
+					},
+					Status: corev1.ResourceQuotaStatus{
+						Used: corev1.ResourceList{corev1.ResourcePods: resource.MustParse(used)},
+					},
+				})
+			}
+
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+			recorder := events.NewFakeRecorder(10)
+
+			r := &Manager{
+				Client:   c,
+				reader:   c,
+				Metrics:  metrics.NewTenantRecorder(),
+				Recorder: recorder,
+			}
+
+			if err := r.syncResourceQuotas(context.Background(), logr.Discard(), tnt); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			close(recorder.Events)
+
+			got := 0
+
+			for e := range recorder.Events {
+				if !strings.Contains(e, evt.ReasonQuotaExceeded) {
+					t.Fatalf("expected reason %s, got %q", evt.ReasonQuotaExceeded, e)
+				}
+
+				got++
+			}
+
+			if got != tt.wantEvents {
+				t.Fatalf("expected %d events, got %d", tt.wantEvents, got)
+			}
+		})
+	}
+}
+
+func TestSyncResourceQuotasRecomputesUsageOnNamespaceAdded(t *testing.T) {
+	scheme := runtime.NewScheme()
+	for _, add := range []func(*runtime.Scheme) error{
+		clientgoscheme.AddToScheme,
+		capsulev1beta2.AddToScheme,
+	} {
+		if err := add(scheme); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+
+	usedKey, err := capsulev1beta2.UsedQuotaFor(corev1.ResourcePods)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	tnt := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "solar"},
+		Spec: capsulev1beta2.TenantSpec{
+			ResourceQuota: api.ResourceQuotaSpec{
+				Scope: api.ResourceQuotaScopeTenant,
+				Items: []corev1.ResourceQuotaSpec{
+					{Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("10")}},
+				},
+			},
+		},
+	}
+
+	quotaFor := func(namespace, used string) *corev1.ResourceQuota {
+		return &corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "capsule-solar-0",
+				Namespace: namespace,
+				Labels: map[string]string{
+					meta.NewTenantLabel:     tnt.Name,
+					meta.ResourceQuotaLabel: "0",
+				},
+			},
+			Spec: corev1.ResourceQuotaSpec{
+				Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("10")},
+			},
+			Status: corev1.ResourceQuotaStatus{
+				Used: corev1.ResourceList{corev1.ResourcePods: resource.MustParse(used)},
+			},
+		}
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(quotaFor("solar-easy", "3")).Build()
+
+	r := &Manager{
+		Client:   c,
+		reader:   c,
+		Metrics:  metrics.NewTenantRecorder(),
+		Recorder: events.NewFakeRecorder(10),
+	}
+
+	assertUsed := func(namespaces []string, want string) {
+		t.Helper()
+
+		if err := r.syncResourceQuotas(context.Background(), logr.Discard(), tnt); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		for _, namespace := range namespaces {
+			got := &corev1.ResourceQuota{}
+			if err := c.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: "capsule-solar-0"}, got); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if got.Annotations[usedKey] != want {
+				t.Fatalf("expected Tenant usage %s in %s, got %q", want, namespace, got.Annotations[usedKey])
+			}
+		}
+	}
+
+	assertUsed([]string{"solar-easy"}, "3")
+
+	// A new Namespace joins the Tenant, bringing its own usage.
+	if err := c.Create(context.Background(), quotaFor("solar-peasy", "2")); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	assertUsed([]string{"solar-easy", "solar-peasy"}, "5")
+}
@@ -17,6 +17,9 @@ type ControllerOptions struct {
 type RuntimeControllerOptions struct {
 	MaxConcurrentReconciles int
 	CacheSyncTimeout        time.Duration
+	// MaxConcurrentQuotaUpdates bounds the parallel ResourceQuota updates issued
+	// while propagating a Tenant-scoped quota: zero or negative values mean unbounded.
+	MaxConcurrentQuotaUpdates int
 }
 
 func (o RuntimeControllerOptions) ToControllerOptions() controller.Options {
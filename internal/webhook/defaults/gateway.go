@@ -6,8 +6,10 @@ package defaults
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -15,8 +17,11 @@ import (
 
 	capsulegateway "github.com/projectcapsule/capsule/internal/webhook/gateway"
 	"github.com/projectcapsule/capsule/internal/webhook/utils"
+	"github.com/projectcapsule/capsule/pkg/api"
 	caperrors "github.com/projectcapsule/capsule/pkg/api/errors"
+	"github.com/projectcapsule/capsule/pkg/api/meta"
 	ad "github.com/projectcapsule/capsule/pkg/runtime/admission"
+	"github.com/projectcapsule/capsule/pkg/runtime/events"
 )
 
 func mutateGatewayDefaults(
@@ -24,6 +29,7 @@ func mutateGatewayDefaults(
 	req admission.Request,
 	c client.Client,
 	decoder admission.Decoder,
+	recorder events.EventRecorder,
 	namespce string,
 ) *admission.Response {
 	gatewayObj := &gatewayv1.Gateway{}
@@ -42,12 +48,65 @@ func mutateGatewayDefaults(
 		return nil
 	}
 
+	// Stamping the Tenant on the Gateway allows further lookups to resolve it by name.
+	gatewayLabels := gatewayObj.GetLabels()
+	if gatewayLabels == nil {
+		gatewayLabels = map[string]string{}
+	}
+
+	stamped := gatewayLabels[meta.NewTenantLabel] != tnt.Name
+	gatewayLabels[meta.NewTenantLabel] = tnt.Name
+
+	gatewayObj.SetLabels(gatewayLabels)
+
 	allowed := tnt.Spec.GatewayOptions.AllowedClasses
 
-	if allowed == nil || allowed.Default == "" {
+	defaulted, denied := defaultGatewayClass(ctx, c, gatewayObj, allowed)
+	if denied != nil {
+		return denied
+	}
+
+	if defaulted {
+		recorder.LabeledEvent(
+			gatewayObj,
+			corev1.EventTypeNormal,
+			events.ReasonTenantDefaulted,
+			events.ActionMutated,
+			fmt.Sprintf("Gateway %s/%s GatewayClass defaulted to %s by Tenant %s", gatewayObj.Namespace, gatewayObj.Name, allowed.Default, tnt.Name),
+		).
+			WithRelated(tnt).
+			WithTenantLabel(tnt).
+			WithRequestAnnotations(req).
+			Emit(ctx)
+	}
+
+	if !stamped && !defaulted {
 		return nil
 	}
 
+	marshaled, err := json.Marshal(gatewayObj)
+	if err != nil {
+		response := admission.Errored(http.StatusInternalServerError, err)
+
+		return &response
+	}
+
+	response := admission.PatchResponseFromRaw(req.Object.Raw, marshaled)
+
+	return &response
+}
+
+// Applies the Tenant default GatewayClass, returning true when the GatewayClass has been changed.
+func defaultGatewayClass(
+	ctx context.Context,
+	c client.Client,
+	gatewayObj *gatewayv1.Gateway,
+	allowed *api.DefaultAllowedListSpec,
+) (bool, *admission.Response) {
+	if allowed == nil || allowed.Default == "" {
+		return false, nil
+	}
+
 	var mutate bool
 
 	gatewayClass, err := utils.GetGatewayClassClassByObjectName(ctx, c, gatewayObj.Spec.GatewayClassName)
@@ -56,32 +115,25 @@ func mutateGatewayDefaults(
 		if gatewayObj.Spec.GatewayClassName == ("") {
 			mutate = true
 		} else {
-			return ad.Deny(caperrors.NewGatewayError(gatewayObj.Spec.GatewayClassName, err).Error())
+			return false, ad.Deny(caperrors.NewGatewayError(gatewayObj.Spec.GatewayClassName, err).Error())
 		}
 	}
 
 	if gatewayClass != nil && gatewayClass.Name != allowed.Default {
 		if err != nil && !k8serrors.IsNotFound(err) {
-			return ad.Deny(caperrors.NewGatewayClassError(gatewayClass.Name, err).Error())
+			return false, ad.Deny(caperrors.NewGatewayClassError(gatewayClass.Name, err).Error())
 		}
 	} else {
 		mutate = true
 	}
 
 	if mutate = mutate || (gatewayClass.Name == allowed.Default); !mutate {
-		return nil
+		return false, nil
 	}
 
-	gatewayObj.Spec.GatewayClassName = gatewayv1.ObjectName(allowed.Default)
+	previous := gatewayObj.Spec.GatewayClassName
 
-	marshaled, err := json.Marshal(gatewayObj)
-	if err != nil {
-		response := admission.Errored(http.StatusInternalServerError, err)
-
-		return &response
-	}
-
-	response := admission.PatchResponseFromRaw(req.Object.Raw, marshaled)
+	gatewayObj.Spec.GatewayClassName = gatewayv1.ObjectName(allowed.Default)
 
-	return &response
+	return previous != gatewayObj.Spec.GatewayClassName, nil
 }
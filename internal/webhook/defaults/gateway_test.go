@@ -0,0 +1,173 @@
+// Copyright 2020-2026 Project Capsule Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package defaults
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	admissionv1 "k8s.io/api/admission/v1"
+	eventsv1 "k8s.io/api/events/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+	"github.com/projectcapsule/capsule/pkg/api/meta"
+	"github.com/projectcapsule/capsule/pkg/runtime/events"
+	"github.com/projectcapsule/capsule/pkg/runtime/indexers/tenant"
+)
+
+func TestMutateGatewayDefaults(t *testing.T) {
+	tests := []struct {
+		name       string
+		class      string
+		wantEvents int
+	}{
+		{
+			name:       "missing class is defaulted",
+			class:      "",
+			wantEvents: 1,
+		},
+		{
+			name:       "default class is kept",
+			class:      "default-class",
+			wantEvents: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			c, decoder := gatewayDefaultsClientForTest(t)
+			recorder := events.NewEventRecorder(c, logr.Discard(), nil, nil)
+
+			gw := &gatewayv1.Gateway{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: gatewayv1.GroupVersion.String(),
+					Kind:       "Gateway",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "gateway",
+					Namespace: "tenant-ns",
+				},
+				Spec: gatewayv1.GatewaySpec{
+					GatewayClassName: gatewayv1.ObjectName(tt.class),
+				},
+			}
+
+			raw, err := json.Marshal(gw)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			req := admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UID:       "request-uid",
+					Name:      gw.Name,
+					Namespace: gw.Namespace,
+					Operation: admissionv1.Create,
+					Object:    runtime.RawExtension{Raw: raw},
+				},
+			}
+
+			response := mutateGatewayDefaults(ctx, req, c, decoder, recorder, gw.Namespace)
+			if response == nil {
+				t.Fatalf("expected response, got nil")
+			}
+
+			stamped := false
+
+			for _, patch := range response.Patches {
+				if strings.HasPrefix(patch.Path, "/metadata/labels") {
+					stamped = true
+				}
+			}
+
+			if !stamped {
+				t.Fatalf("expected the tenant label to be stamped, got patches %v", response.Patches)
+			}
+
+			list := &eventsv1.EventList{}
+			if err := c.List(ctx, list, client.InNamespace(gw.Namespace)); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if len(list.Items) != tt.wantEvents {
+				t.Fatalf("expected %d events, got %d", tt.wantEvents, len(list.Items))
+			}
+
+			for _, evt := range list.Items {
+				if evt.Reason != events.ReasonTenantDefaulted {
+					t.Fatalf("expected reason %s, got %s", events.ReasonTenantDefaulted, evt.Reason)
+				}
+
+				if evt.Regarding.Name != gw.Name || evt.Regarding.Namespace != gw.Namespace {
+					t.Fatalf("expected event regarding %s/%s, got %s/%s", gw.Namespace, gw.Name, evt.Regarding.Namespace, evt.Regarding.Name)
+				}
+
+				if evt.Labels[meta.NewTenantLabel] != "solar" {
+					t.Fatalf("expected tenant label solar, got %q", evt.Labels[meta.NewTenantLabel])
+				}
+			}
+		})
+	}
+}
+
+func gatewayDefaultsClientForTest(t *testing.T) (client.Client, admission.Decoder) {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+
+	for _, add := range []func(*runtime.Scheme) error{
+		clientgoscheme.AddToScheme,
+		capsulev1beta2.AddToScheme,
+		gatewayv1.Install,
+	} {
+		if err := add(scheme); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+
+	tnt := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "solar",
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			GatewayOptions: capsulev1beta2.GatewayOptions{
+				AllowedClasses: &api.DefaultAllowedListSpec{
+					Default: "default-class",
+				},
+			},
+		},
+		Status: capsulev1beta2.TenantStatus{
+			Namespaces: []string{"tenant-ns"},
+		},
+	}
+
+	class := &gatewayv1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "default-class",
+		},
+	}
+
+	indexer := tenant.NamespacesReference{Obj: &capsulev1beta2.Tenant{}}
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(tnt, class).
+		WithIndex(indexer.Object(), indexer.Field(), indexer.Func()).
+		Build()
+
+	return c, admission.NewDecoder(scheme)
+}
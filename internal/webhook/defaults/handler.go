@@ -32,10 +32,10 @@ func (h *handler) OnCreate(
 	c client.Client,
 	_ client.Reader,
 	decoder admission.Decoder,
-	_ events.EventRecorder,
+	recorder events.EventRecorder,
 ) handlers.Func {
 	return func(ctx context.Context, req admission.Request) *admission.Response {
-		return h.mutate(ctx, req, c, decoder)
+		return h.mutate(ctx, req, c, decoder, recorder)
 	}
 }
 
@@ -54,14 +54,20 @@ func (h *handler) OnUpdate(
 	c client.Client,
 	_ client.Reader,
 	decoder admission.Decoder,
-	_ events.EventRecorder,
+	recorder events.EventRecorder,
 ) handlers.Func {
 	return func(ctx context.Context, req admission.Request) *admission.Response {
-		return h.mutate(ctx, req, c, decoder)
+		return h.mutate(ctx, req, c, decoder, recorder)
 	}
 }
 
-func (h *handler) mutate(ctx context.Context, req admission.Request, c client.Client, decoder admission.Decoder) *admission.Response {
+func (h *handler) mutate(
+	ctx context.Context,
+	req admission.Request,
+	c client.Client,
+	decoder admission.Decoder,
+	recorder events.EventRecorder,
+) *admission.Response {
 	var response *admission.Response
 
 	switch req.Resource {
@@ -72,7 +78,7 @@ func (h *handler) mutate(ctx context.Context, req admission.Request, c client.Cl
 	case metav1.GroupVersionResource{Group: "networking.k8s.io", Version: "v1", Resource: "ingresses"}, metav1.GroupVersionResource{Group: "networking.k8s.io", Version: "v1beta1", Resource: "ingresses"}:
 		response = mutateIngressDefaults(ctx, req, h.version, c, decoder, req.Namespace)
 	case metav1.GroupVersionResource{Group: "gateway.networking.k8s.io", Version: "v1", Resource: "gateways"}:
-		response = mutateGatewayDefaults(ctx, req, c, decoder, req.Namespace)
+		response = mutateGatewayDefaults(ctx, req, c, decoder, recorder, req.Namespace)
 	}
 
 	if response == nil {
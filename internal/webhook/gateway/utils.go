@@ -5,14 +5,37 @@ package gateway
 
 import (
 	"context"
+	"errors"
+	"slices"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/internal/webhook/utils"
+	"github.com/projectcapsule/capsule/pkg/api/meta"
 )
 
+// TenantFromGateway resolves the Tenant owning the Gateway namespace. Gateways stamped with the
+// Tenant label are resolved by name, as long as the Tenant still owns the namespace: otherwise,
+// and for Gateways created before the label was introduced, the namespaces index is used.
 func TenantFromGateway(ctx context.Context, c client.Client, gateway *v1.Gateway) (*capsulev1beta2.Tenant, error) {
+	if name, ok := gateway.GetLabels()[meta.NewTenantLabel]; ok && name != "" {
+		tnt := &capsulev1beta2.Tenant{}
+
+		err := c.Get(ctx, types.NamespacedName{Name: name}, tnt)
+
+		switch {
+		case err == nil && slices.Contains(tnt.Status.Namespaces, gateway.Namespace):
+			return tnt, nil
+		case err != nil && !apierrors.IsNotFound(err):
+			return nil, err
+		}
+	}
+
 	tenantList := &capsulev1beta2.TenantList{}
 	if err := c.List(ctx, tenantList, client.MatchingFields{".status.namespaces": gateway.Namespace}); err != nil {
 		return nil, err
@@ -24,3 +47,20 @@ func TenantFromGateway(ctx context.Context, c client.Client, gateway *v1.Gateway
 
 	return &tenantList.Items[0], nil
 }
+
+// GatewayClassByName resolves the GatewayClass from the informer cache, keeping the lookup
+// off the API server on the admission hot path. The live reader is used as long as the
+// cache has not been started yet.
+func GatewayClassByName(ctx context.Context, c client.Client, reader client.Reader, name v1.ObjectName) (*v1.GatewayClass, error) {
+	gatewayClass, err := utils.GetGatewayClassClassByObjectName(ctx, c, name)
+	if err == nil {
+		return gatewayClass, nil
+	}
+
+	var notStarted *cache.ErrCacheNotStarted
+	if reader == nil || !errors.As(err, &notStarted) {
+		return nil, err
+	}
+
+	return utils.GetGatewayClassClassByObjectName(ctx, reader, name)
+}
@@ -0,0 +1,163 @@
+// Copyright 2020-2026 Project Capsule Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gateway
+
+import (
+	"context"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api/meta"
+	"github.com/projectcapsule/capsule/pkg/runtime/indexers/tenant"
+)
+
+func TestGatewayClassByName(t *testing.T) {
+	tests := []struct {
+		name     string
+		cacheErr error
+		wantErr  bool
+	}{
+		{
+			name: "resolved from cache",
+		},
+		{
+			name:     "falls back to reader when cache is not started",
+			cacheErr: &cache.ErrCacheNotStarted{},
+		},
+		{
+			name:     "cache errors are returned",
+			cacheErr: apierrors.NewNotFound(schema.GroupResource{Group: gatewayv1.GroupName, Resource: "gatewayclasses"}, "class"),
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			if err := gatewayv1.Install(scheme); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			class := &gatewayv1.GatewayClass{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "class",
+				},
+			}
+
+			reader := fake.NewClientBuilder().WithScheme(scheme).WithObjects(class).Build()
+
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(class).
+				WithInterceptorFuncs(interceptor.Funcs{
+					Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+						if tt.cacheErr != nil {
+							return tt.cacheErr
+						}
+
+						return c.Get(ctx, key, obj, opts...)
+					},
+				}).
+				Build()
+
+			got, err := GatewayClassByName(context.Background(), c, reader, "class")
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if got == nil || got.Name != class.Name {
+				t.Fatalf("expected GatewayClass %s, got %v", class.Name, got)
+			}
+		})
+	}
+}
+
+func TestTenantFromGateway(t *testing.T) {
+	tests := []struct {
+		name       string
+		labels     map[string]string
+		wantTenant string
+	}{
+		{
+			name:       "resolved by namespaces index without label",
+			wantTenant: "solar",
+		},
+		{
+			name:       "resolved by tenant label",
+			labels:     map[string]string{meta.NewTenantLabel: "solar"},
+			wantTenant: "solar",
+		},
+		{
+			name:       "label of tenant not owning the namespace falls back to the index",
+			labels:     map[string]string{meta.NewTenantLabel: "wind"},
+			wantTenant: "solar",
+		},
+		{
+			name:       "label of missing tenant falls back to the index",
+			labels:     map[string]string{meta.NewTenantLabel: "missing"},
+			wantTenant: "solar",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			if err := capsulev1beta2.AddToScheme(scheme); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			indexer := tenant.NamespacesReference{Obj: &capsulev1beta2.Tenant{}}
+
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(
+					&capsulev1beta2.Tenant{
+						ObjectMeta: metav1.ObjectMeta{Name: "solar"},
+						Status:     capsulev1beta2.TenantStatus{Namespaces: []string{"solar-ns"}},
+					},
+					&capsulev1beta2.Tenant{
+						ObjectMeta: metav1.ObjectMeta{Name: "wind"},
+						Status:     capsulev1beta2.TenantStatus{Namespaces: []string{"wind-ns"}},
+					},
+				).
+				WithIndex(indexer.Object(), indexer.Field(), indexer.Func()).
+				Build()
+
+			gw := &gatewayv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "gateway",
+					Namespace: "solar-ns",
+					Labels:    tt.labels,
+				},
+			}
+
+			got, err := TenantFromGateway(context.Background(), c, gw)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if got == nil || got.Name != tt.wantTenant {
+				t.Fatalf("expected tenant %s, got %v", tt.wantTenant, got)
+			}
+		})
+	}
+}
@@ -6,16 +6,14 @@ package gateway
 import (
 	"context"
 	"fmt"
-	"net/http"
 
+	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
-	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
-	"github.com/projectcapsule/capsule/internal/webhook/utils"
 	caperrors "github.com/projectcapsule/capsule/pkg/api/errors"
 	ad "github.com/projectcapsule/capsule/pkg/runtime/admission"
 	"github.com/projectcapsule/capsule/pkg/runtime/configuration"
@@ -35,23 +33,23 @@ func Class(configuration configuration.Configuration) handlers.Handler {
 
 func (r *class) OnCreate(
 	c client.Client,
-	_ client.Reader,
+	reader client.Reader,
 	decoder admission.Decoder,
 	recorder events.EventRecorder,
 ) handlers.Func {
 	return func(ctx context.Context, req admission.Request) *admission.Response {
-		return r.validate(ctx, c, req, decoder, recorder)
+		return r.validate(ctx, c, reader, req, decoder, recorder)
 	}
 }
 
 func (r *class) OnUpdate(
 	c client.Client,
-	_ client.Reader,
+	reader client.Reader,
 	decoder admission.Decoder,
 	recorder events.EventRecorder,
 ) handlers.Func {
 	return func(ctx context.Context, req admission.Request) *admission.Response {
-		return r.validate(ctx, c, req, decoder, recorder)
+		return r.validate(ctx, c, reader, req, decoder, recorder)
 	}
 }
 
@@ -69,6 +67,7 @@ func (r *class) OnDelete(
 func (r *class) validate(
 	ctx context.Context,
 	c client.Client,
+	reader client.Reader,
 	req admission.Request,
 	decoder admission.Decoder,
 	recorder events.EventRecorder,
@@ -95,7 +94,7 @@ func (r *class) validate(
 		return nil
 	}
 
-	gatewayClass, err := utils.GetGatewayClassClassByObjectName(ctx, c, gatewayObj.Spec.GatewayClassName)
+	gatewayClass, err := GatewayClassByName(ctx, c, reader, gatewayObj.Spec.GatewayClassName)
 	if err != nil {
 		return ad.ErroredResponse(err)
 	}
@@ -116,22 +115,42 @@ func (r *class) validate(
 		return ad.Deny(caperrors.NewGatewayClassUndefined(*allowed).Error())
 	}
 
-	selector := false
-	// Verify if the GatewayClass exists and matches the label selector/expression
-	if len(allowed.MatchExpressions) > 0 || len(allowed.MatchLabels) > 0 {
-		gatewayClassObj, err := utils.GetGatewayClassClassByObjectName(ctx, c, gatewayObj.Spec.GatewayClassName)
-		if err != nil && !k8serrors.IsNotFound(err) {
-			response := admission.Errored(http.StatusInternalServerError, err)
+	// A terminating GatewayClass may only be kept by Gateways already referencing it
+	if gatewayClass.GetDeletionTimestamp() != nil {
+		referenced := false
 
-			return &response
+		if req.Operation == admissionv1.Update {
+			oldGateway := &gatewayv1.Gateway{}
+			if err := decoder.DecodeRaw(req.OldObject, oldGateway); err != nil {
+				return ad.ErroredResponse(err)
+			}
+
+			referenced = oldGateway.Spec.GatewayClassName == gatewayObj.Spec.GatewayClassName
 		}
 
-		// Gateway Class is present, check if it matches the selector
-		if gatewayClassObj != nil {
-			selector = allowed.SelectorMatch(gatewayClassObj)
+		if !referenced {
+			recorder.LabeledEvent(
+				gatewayObj,
+				corev1.EventTypeWarning,
+				events.ReasonTerminatingGatewayClass,
+				events.ActionValidationDenied,
+				fmt.Sprintf("Gateway %s/%s GatewayClass %s is being deleted", req.Namespace, req.Name, gatewayClass.GetName()),
+			).
+				WithRelated(tnt).
+				WithTenantLabel(tnt).
+				WithRequestAnnotations(req).
+				Emit(ctx)
+
+			return ad.Deny(caperrors.NewGatewayClassTerminating(gatewayClass.GetName()).Error())
 		}
 	}
 
+	selector := false
+	// Verify if the GatewayClass matches the label selector/expression
+	if len(allowed.MatchExpressions) > 0 || len(allowed.MatchLabels) > 0 {
+		selector = allowed.SelectorMatch(gatewayClass)
+	}
+
 	switch {
 	case allowed.MatchDefault(gatewayClass.Name):
 		return nil
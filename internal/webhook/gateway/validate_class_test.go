@@ -0,0 +1,155 @@
+// Copyright 2020-2026 Project Capsule Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-logr/logr"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+	"github.com/projectcapsule/capsule/pkg/runtime/events"
+	"github.com/projectcapsule/capsule/pkg/runtime/indexers/tenant"
+)
+
+func TestClassValidateTerminatingGatewayClass(t *testing.T) {
+	tests := []struct {
+		name        string
+		operation   admissionv1.Operation
+		terminating bool
+		oldClass    string
+		wantDenied  bool
+	}{
+		{
+			name:      "create against active class is allowed",
+			operation: admissionv1.Create,
+		},
+		{
+			name:        "create against terminating class is denied",
+			operation:   admissionv1.Create,
+			terminating: true,
+			wantDenied:  true,
+		},
+		{
+			name:        "update switching to terminating class is denied",
+			operation:   admissionv1.Update,
+			terminating: true,
+			oldClass:    "other",
+			wantDenied:  true,
+		},
+		{
+			name:        "update keeping terminating class is allowed",
+			operation:   admissionv1.Update,
+			terminating: true,
+			oldClass:    "class",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			scheme := runtime.NewScheme()
+			for _, add := range []func(*runtime.Scheme) error{
+				clientgoscheme.AddToScheme,
+				capsulev1beta2.AddToScheme,
+				gatewayv1.Install,
+			} {
+				if err := add(scheme); err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+			}
+
+			tnt := &capsulev1beta2.Tenant{
+				ObjectMeta: metav1.ObjectMeta{Name: "solar"},
+				Spec: capsulev1beta2.TenantSpec{
+					GatewayOptions: capsulev1beta2.GatewayOptions{
+						AllowedClasses: &api.DefaultAllowedListSpec{
+							SelectorAllowedListSpec: api.SelectorAllowedListSpec{
+								AllowedListSpec: api.AllowedListSpec{
+									Exact: []string{"class", "other"},
+								},
+							},
+						},
+					},
+				},
+				Status: capsulev1beta2.TenantStatus{Namespaces: []string{"solar-ns"}},
+			}
+
+			gatewayClass := &gatewayv1.GatewayClass{
+				ObjectMeta: metav1.ObjectMeta{Name: "class"},
+			}
+
+			if tt.terminating {
+				now := metav1.Now()
+				gatewayClass.DeletionTimestamp = &now
+				gatewayClass.Finalizers = []string{"gateway-exists-finalizer.gateway.networking.k8s.io"}
+			}
+
+			indexer := tenant.NamespacesReference{Obj: &capsulev1beta2.Tenant{}}
+
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tnt, gatewayClass).
+				WithIndex(indexer.Object(), indexer.Field(), indexer.Func()).
+				Build()
+
+			req := admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UID:       "request-uid",
+					Name:      "gateway",
+					Namespace: "solar-ns",
+					Operation: tt.operation,
+					Object:    runtime.RawExtension{Raw: gatewayRawForTest(t, "class")},
+				},
+			}
+
+			if tt.oldClass != "" {
+				req.OldObject = runtime.RawExtension{Raw: gatewayRawForTest(t, tt.oldClass)}
+			}
+
+			recorder := events.NewEventRecorder(c, logr.Discard(), nil, nil)
+
+			response := (&class{}).validate(ctx, c, c, req, admission.NewDecoder(scheme), recorder)
+
+			denied := response != nil && !response.Allowed
+			if denied != tt.wantDenied {
+				t.Fatalf("expected denied %t, got %t (%v)", tt.wantDenied, denied, response)
+			}
+		})
+	}
+}
+
+func gatewayRawForTest(t *testing.T, className string) []byte {
+	t.Helper()
+
+	raw, err := json.Marshal(&gatewayv1.Gateway{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gatewayv1.GroupVersion.String(),
+			Kind:       "Gateway",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gateway",
+			Namespace: "solar-ns",
+		},
+		Spec: gatewayv1.GatewaySpec{
+			GatewayClassName: gatewayv1.ObjectName(className),
+		},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	return raw
+}
@@ -5,13 +5,16 @@ package validation
 
 import (
 	"context"
+	"regexp"
 	"strings"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
 	"github.com/projectcapsule/capsule/pkg/api/meta"
 	"github.com/projectcapsule/capsule/pkg/runtime/configuration"
 	"github.com/projectcapsule/capsule/pkg/runtime/events"
@@ -29,14 +32,14 @@ func WarningHandler(cfg configuration.Configuration) handlers.TypedHandler[*caps
 }
 
 func (h *warningHandler) OnCreate(
-	_ client.Client,
+	c client.Client,
 	_ client.Reader,
 	tnt *capsulev1beta2.Tenant,
 	_ admission.Decoder,
 	_ events.EventRecorder,
 ) handlers.Func {
 	return func(ctx context.Context, req admission.Request) *admission.Response {
-		return h.handle(tnt, req)
+		return h.handle(ctx, c, tnt, req)
 	}
 }
 
@@ -53,19 +56,19 @@ func (h *warningHandler) OnDelete(
 }
 
 func (h *warningHandler) OnUpdate(
-	_ client.Client,
+	c client.Client,
 	_ client.Reader,
 	tnt *capsulev1beta2.Tenant,
 	old *capsulev1beta2.Tenant,
 	_ admission.Decoder,
 	_ events.EventRecorder,
 ) handlers.Func {
-	return func(_ context.Context, req admission.Request) *admission.Response {
-		return h.handle(tnt, req)
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		return h.handle(ctx, c, tnt, req)
 	}
 }
 
-func (h *warningHandler) handle(tnt *capsulev1beta2.Tenant, req admission.Request) *admission.Response {
+func (h *warningHandler) handle(ctx context.Context, c client.Client, tnt *capsulev1beta2.Tenant, req admission.Request) *admission.Response {
 	response := &admission.Response{
 		AdmissionResponse: admissionv1.AdmissionResponse{
 			UID:     req.UID,
@@ -117,6 +120,18 @@ func (h *warningHandler) handle(tnt *capsulev1beta2.Tenant, req admission.Reques
 		)
 	}
 
+	if tnt.Spec.GatewayOptions.AllowedClasses != nil && tnt.Spec.GatewayOptions.AllowedClasses.IsEmpty() {
+		response.Warnings = append(response.Warnings,
+			"The field `gatewayOptions.allowedClasses` declares neither a default, allowed classes nor a selector: no GatewayClass can be used within the Tenant.",
+		)
+	}
+
+	if !gatewayClassesMatched(ctx, c, tnt.Spec.GatewayOptions.AllowedClasses) {
+		response.Warnings = append(response.Warnings,
+			"The field `gatewayOptions.allowedClasses` currently matches no existing GatewayClass and declares no default: Gateways within the Tenant will be denied until a matching GatewayClass is created.",
+		)
+	}
+
 	//nolint:staticcheck
 	if tnt.Spec.PriorityClasses != nil && tnt.Spec.PriorityClasses.Regex != "" {
 		response.Warnings = append(response.Warnings,
@@ -143,3 +158,35 @@ func (h *warningHandler) handle(tnt *capsulev1beta2.Tenant, req admission.Reques
 
 	return response
 }
+
+// gatewayClassesMatched returns false only when the allowed GatewayClasses, lacking a default,
+// don't match any GatewayClass currently available in the cluster. Lookup failures, such as the
+// Gateway API not being installed, are not reported since the warning is merely informative.
+func gatewayClassesMatched(ctx context.Context, c client.Client, allowed *api.DefaultAllowedListSpec) bool {
+	if allowed == nil || allowed.Default != "" || allowed.IsEmpty() {
+		return true
+	}
+
+	classes := &gatewayv1.GatewayClassList{}
+	if err := c.List(ctx, classes); err != nil {
+		return true
+	}
+
+	// An empty selector would match every class: it's considered only when declared
+	selector := len(allowed.MatchLabels) > 0 || len(allowed.MatchExpressions) > 0
+
+	// An invalid expression can't match any class, it must not panic the handler
+	var re *regexp.Regexp
+
+	if allowed.Regex != "" {
+		re, _ = regexp.Compile(allowed.Regex)
+	}
+
+	for _, class := range classes.Items {
+		if allowed.ExactMatch(class.Name) || (re != nil && re.MatchString(class.Name)) || (selector && allowed.SelectorMatch(&class)) {
+			return true
+		}
+	}
+
+	return false
+}
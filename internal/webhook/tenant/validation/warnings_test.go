@@ -0,0 +1,149 @@
+// Copyright 2020-2026 Project Capsule Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+func TestWarningHandlerGatewayClassesMatchNothing(t *testing.T) {
+	tests := []struct {
+		name        string
+		allowed     *api.DefaultAllowedListSpec
+		wantWarning bool
+		wantText    string
+	}{
+		{
+			name: "selector matching an existing class",
+			allowed: &api.DefaultAllowedListSpec{
+				SelectorAllowedListSpec: api.SelectorAllowedListSpec{
+					LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+				},
+			},
+		},
+		{
+			name: "selector matching no class",
+			allowed: &api.DefaultAllowedListSpec{
+				SelectorAllowedListSpec: api.SelectorAllowedListSpec{
+					LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "dev"}},
+				},
+			},
+			wantWarning: true,
+		},
+		{
+			name: "selector matching no class with default",
+			allowed: &api.DefaultAllowedListSpec{
+				SelectorAllowedListSpec: api.SelectorAllowedListSpec{
+					LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "dev"}},
+				},
+				Default: "future-class",
+			},
+		},
+		{
+			name: "allowed name of an existing class",
+			allowed: &api.DefaultAllowedListSpec{
+				SelectorAllowedListSpec: api.SelectorAllowedListSpec{
+					AllowedListSpec: api.AllowedListSpec{Exact: []string{"prod-class"}},
+				},
+			},
+		},
+		{
+			name: "allowed name of a missing class",
+			allowed: &api.DefaultAllowedListSpec{
+				SelectorAllowedListSpec: api.SelectorAllowedListSpec{
+					AllowedListSpec: api.AllowedListSpec{Exact: []string{"missing-class"}},
+				},
+			},
+			wantWarning: true,
+		},
+		{
+			name: "allowed regex matching an existing class",
+			allowed: &api.DefaultAllowedListSpec{
+				SelectorAllowedListSpec: api.SelectorAllowedListSpec{
+					AllowedListSpec: api.AllowedListSpec{Regex: "^prod-.*$"},
+				},
+			},
+		},
+		{
+			name: "invalid allowed regex",
+			allowed: &api.DefaultAllowedListSpec{
+				SelectorAllowedListSpec: api.SelectorAllowedListSpec{
+					AllowedListSpec: api.AllowedListSpec{Regex: "("},
+				},
+			},
+			wantWarning: true,
+		},
+		{
+			name:     "empty allowed classes",
+			allowed:  &api.DefaultAllowedListSpec{},
+			wantText: "The field `gatewayOptions.allowedClasses` declares neither a default, allowed classes nor a selector: no GatewayClass can be used within the Tenant.",
+		},
+		{
+			name: "no allowed classes",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			if err := gatewayv1.Install(scheme); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(&gatewayv1.GatewayClass{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "prod-class",
+						Labels: map[string]string{"env": "prod"},
+					},
+				}).
+				Build()
+
+			tnt := &capsulev1beta2.Tenant{
+				ObjectMeta: metav1.ObjectMeta{Name: "solar"},
+				Spec: capsulev1beta2.TenantSpec{
+					GatewayOptions: capsulev1beta2.GatewayOptions{
+						AllowedClasses: tt.allowed,
+					},
+				},
+			}
+
+			req := admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{UID: "request-uid"},
+			}
+
+			response := (&warningHandler{}).handle(context.Background(), c, tnt, req)
+
+			warned := false
+
+			for _, warning := range response.Warnings {
+				if strings.Contains(warning, "matches no existing GatewayClass") {
+					warned = true
+				}
+			}
+
+			if warned != tt.wantWarning {
+				t.Fatalf("expected warning %t, got %t (%v)", tt.wantWarning, warned, response.Warnings)
+			}
+
+			if tt.wantText != "" && !slices.Contains(response.Warnings, tt.wantText) {
+				t.Fatalf("expected warning %q, got %v", tt.wantText, response.Warnings)
+			}
+		})
+	}
+}
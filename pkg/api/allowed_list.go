@@ -25,6 +25,16 @@ func (in *DefaultAllowedListSpec) MatchDefault(value string) bool {
 	return in.Default == value
 }
 
+// IsEmpty returns true when neither a default, allowed names, a regex
+// nor a label selector are declared: such a block matches nothing.
+func (in *DefaultAllowedListSpec) IsEmpty() bool {
+	return in.Default == "" &&
+		len(in.Exact) == 0 &&
+		in.Regex == "" &&
+		len(in.MatchLabels) == 0 &&
+		len(in.MatchExpressions) == 0
+}
+
 // +kubebuilder:object:generate=true
 
 type SelectorAllowedListSpec struct {
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/projectcapsule/capsule/pkg/api"
 )
@@ -74,3 +75,44 @@ func TestAllowedListSpec_RegexMatch(t *testing.T) {
 		}
 	}
 }
+
+func TestDefaultAllowedListSpec_IsEmpty(t *testing.T) {
+	type tc struct {
+		Name  string
+		In    api.DefaultAllowedListSpec
+		Empty bool
+	}
+
+	for _, tc := range []tc{
+		{
+			Name:  "nothing declared",
+			In:    api.DefaultAllowedListSpec{},
+			Empty: true,
+		},
+		{
+			Name:  "empty selector",
+			In:    api.DefaultAllowedListSpec{SelectorAllowedListSpec: api.SelectorAllowedListSpec{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{}}}},
+			Empty: true,
+		},
+		{
+			Name: "default only",
+			In:   api.DefaultAllowedListSpec{Default: "default-class"},
+		},
+		{
+			Name: "exact only",
+			In:   api.DefaultAllowedListSpec{SelectorAllowedListSpec: api.SelectorAllowedListSpec{AllowedListSpec: api.AllowedListSpec{Exact: []string{"foo"}}}},
+		},
+		{
+			Name: "match labels only",
+			In:   api.DefaultAllowedListSpec{SelectorAllowedListSpec: api.SelectorAllowedListSpec{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}}}},
+		},
+		{
+			Name: "match expressions only",
+			In: api.DefaultAllowedListSpec{SelectorAllowedListSpec: api.SelectorAllowedListSpec{LabelSelector: metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "env", Operator: metav1.LabelSelectorOpExists}},
+			}}},
+		},
+	} {
+		assert.Equal(t, tc.Empty, tc.In.IsEmpty(), tc.Name)
+	}
+}
@@ -75,3 +75,17 @@ func NewGatewayClassUndefined(spec api.DefaultAllowedListSpec) error {
 func (i GatewayClassUndefinedError) Error() string {
 	return DefaultAllowedValuesErrorMessage(i.spec, "No gateway Class is forbidden for the current Tenant. Specify a gateway Class which is allowed within the Tenant: ")
 }
+
+type GatewayClassTerminatingError struct {
+	gatewayClassName string
+}
+
+func NewGatewayClassTerminating(class string) error {
+	return &GatewayClassTerminatingError{
+		gatewayClassName: class,
+	}
+}
+
+func (i GatewayClassTerminatingError) Error() string {
+	return fmt.Sprintf("Gateway Class %s is being deleted and cannot be referenced by new Gateways", i.gatewayClassName)
+}
@@ -33,6 +33,7 @@ const (
 	InUseReason                   string = "InUse"
 	UnusedReason                  string = "Unused"
 	PendingUnmanagedContentReason string = "PendingUnmanagedContent"
+	InvalidSelectorReason         string = "InvalidSelector"
 )
 
 func IsStatusConditionTrue(conditions ConditionList, conditionType string) bool {
@@ -29,16 +29,17 @@ const (
 	ReasonPromotionDenied     string = "ReasonPromotionDenied"
 
 	// Classes.
-	ReasonMissingStorageClass    string = "MissingStorageClass"
-	ReasonForbiddenStorageClass  string = "ForbiddenStorageClass"
-	ReasonForbiddenPriorityClass string = "ForbiddenPriorityClass"
-	ReasonForbiddenRuntimeClass  string = "ForbiddenRuntimeClass"
-	ReasonForbiddenIngressClass  string = "ForbiddenIngressClass"
-	ReasonMissingIngressClass    string = "MissingIngressClass"
-	ReasonForbiddenGatewayClass  string = "ForbiddenGatewayClass"
-	ReasonMissingGatewayClass    string = "MissingGatewayClass"
-	ReasonMissingDeviceClass     string = "MissingDeviceClass"
-	ReasonForbiddenDeviceClass   string = "ForbiddenDeviceClass"
+	ReasonMissingStorageClass     string = "MissingStorageClass"
+	ReasonForbiddenStorageClass   string = "ForbiddenStorageClass"
+	ReasonForbiddenPriorityClass  string = "ForbiddenPriorityClass"
+	ReasonForbiddenRuntimeClass   string = "ForbiddenRuntimeClass"
+	ReasonForbiddenIngressClass   string = "ForbiddenIngressClass"
+	ReasonMissingIngressClass     string = "MissingIngressClass"
+	ReasonForbiddenGatewayClass   string = "ForbiddenGatewayClass"
+	ReasonMissingGatewayClass     string = "MissingGatewayClass"
+	ReasonTerminatingGatewayClass string = "TerminatingGatewayClass"
+	ReasonMissingDeviceClass      string = "MissingDeviceClass"
+	ReasonForbiddenDeviceClass    string = "ForbiddenDeviceClass"
 
 	// Nodes.
 	ReasonForbiddenNodeSelectorUpdate string = "ForbiddenNodeSelectorUpdate"
@@ -0,0 +1,50 @@
+// Copyright 2020-2026 Project Capsule Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package selectors
+
+import (
+	"errors"
+)
+
+// SelectorError is returned when a selector can't be converted into a labels.Selector.
+// Retrying won't help until the selector itself is changed.
+type SelectorError struct {
+	Err error
+}
+
+func (e *SelectorError) Error() string {
+	return "invalid namespace selector: " + e.Err.Error()
+}
+
+func (e *SelectorError) Unwrap() error {
+	return e.Err
+}
+
+// TransientError is returned when the selected objects can't be retrieved from the API,
+// the operation is expected to succeed on a later attempt.
+type TransientError struct {
+	Err error
+}
+
+func (e *TransientError) Error() string {
+	return "failed to list namespaces: " + e.Err.Error()
+}
+
+func (e *TransientError) Unwrap() error {
+	return e.Err
+}
+
+// IsSelectorError reports whether any error in err's tree is a SelectorError.
+func IsSelectorError(err error) bool {
+	var target *SelectorError
+
+	return errors.As(err, &target)
+}
+
+// IsTransientError reports whether any error in err's tree is a TransientError.
+func IsTransientError(err error) bool {
+	var target *TransientError
+
+	return errors.As(err, &target)
+}
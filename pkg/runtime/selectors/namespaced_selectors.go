@@ -33,12 +33,12 @@ func (s *NamespaceSelector) GetMatchingNamespaces(
 
 	nsSelector, err := metav1.LabelSelectorAsSelector(s.LabelSelector)
 	if err != nil {
-		return nil, fmt.Errorf("invalid namespace selector: %w", err)
+		return nil, &SelectorError{Err: err}
 	}
 
 	namespaceList := &corev1.NamespaceList{}
 	if err := c.List(ctx, namespaceList); err != nil {
-		return nil, fmt.Errorf("failed to list namespaces: %w", err)
+		return nil, &TransientError{Err: err}
 	}
 
 	var matchingNamespaces []corev1.Namespace
@@ -0,0 +1,102 @@
+// Copyright 2020-2026 Project Capsule Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package selectors_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/projectcapsule/capsule/pkg/runtime/selectors"
+)
+
+func TestGetMatchingNamespacesErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		selector      *metav1.LabelSelector
+		listErr       error
+		wantMatches   int
+		wantSelector  bool
+		wantTransient bool
+	}{
+		{
+			name:        "matching namespaces",
+			selector:    &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+			wantMatches: 1,
+		},
+		{
+			name: "invalid selector operator",
+			selector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "env", Operator: "Unknown", Values: []string{"prod"}},
+				},
+			},
+			wantSelector: true,
+		},
+		{
+			name:          "namespaces can't be listed",
+			selector:      &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+			listErr:       errors.New("connection refused"),
+			wantTransient: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			scheme := runtime.NewScheme()
+			if err := clientgoscheme.AddToScheme(scheme); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(
+					&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "solar-prod", Labels: map[string]string{"env": "prod"}}},
+					&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "solar-dev", Labels: map[string]string{"env": "dev"}}},
+				).
+				WithInterceptorFuncs(interceptor.Funcs{
+					List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+						if tt.listErr != nil {
+							return tt.listErr
+						}
+
+						return c.List(ctx, list, opts...)
+					},
+				}).
+				Build()
+
+			selector := &selectors.NamespaceSelector{LabelSelector: tt.selector}
+
+			matches, err := selector.GetMatchingNamespaces(context.Background(), c)
+
+			if got := selectors.IsSelectorError(err); got != tt.wantSelector {
+				t.Fatalf("expected selector error %t, got %t (%v)", tt.wantSelector, got, err)
+			}
+
+			if got := selectors.IsTransientError(err); got != tt.wantTransient {
+				t.Fatalf("expected transient error %t, got %t (%v)", tt.wantTransient, got, err)
+			}
+
+			if tt.listErr != nil && !errors.Is(err, tt.listErr) {
+				t.Fatalf("expected error wrapping %v, got %v", tt.listErr, err)
+			}
+
+			if len(matches) != tt.wantMatches {
+				t.Fatalf("expected %d matching namespaces, got %d", tt.wantMatches, len(matches))
+			}
+		})
+	}
+}